@@ -0,0 +1,237 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package advisor implements a heuristic SQL advisor: a set of pluggable
+// rules that walk a parsed statement and report findings such as
+// always-true predicates, implicit type conversions or non-sargable
+// predicates. Findings are surfaced to the client through the
+// `EXPLAIN ADVISOR <sql>` statement.
+package advisor
+
+import (
+	"sort"
+	"sync"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+import (
+	"github.com/arana-db/arana/pkg/runtime/ast"
+)
+
+// Severity classifies how serious a Finding is.
+type Severity uint8
+
+const (
+	// SeverityInfo is a stylistic or informational observation.
+	SeverityInfo Severity = iota
+	// SeverityWarning indicates a likely performance or correctness issue.
+	SeverityWarning
+	// SeverityError indicates a predicate or pattern that is very likely a bug.
+	SeverityError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "INFO"
+	case SeverityWarning:
+		return "WARNING"
+	case SeverityError:
+		return "ERROR"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Finding is a single observation emitted by a Rule against a statement.
+type Finding struct {
+	// RuleID is the stable, grep-able code of the rule that produced this
+	// finding, eg. "ARG.001" or "KWR.004".
+	RuleID string
+	// Severity classifies how serious the finding is.
+	Severity Severity
+	// Position is the best-effort location of the offending fragment within
+	// the original SQL text.
+	Position string
+	// Message explains what was found.
+	Message string
+	// Suggestion is an optional human-readable remediation hint.
+	Suggestion string
+}
+
+// Input is everything Advise extracts from a statement before handing it
+// to each Rule, so individual rules stay free of ast.Statement type
+// switches.
+type Input struct {
+	// Conds are the statement's WHERE/HAVING/ON predicates, as returned by
+	// ConditionsOf.
+	Conds []ast.PredicateNode
+	// Wildcard reports whether the statement's projection is `SELECT *`.
+	Wildcard bool
+	// ShardedFromTables are the statement's FROM tables that are sharded,
+	// as reported by the isSharded function passed to Advise.
+	ShardedFromTables []string
+}
+
+// Rule inspects a statement's extracted Input and reports zero or more
+// findings. Rules must be side-effect free and safe for concurrent use.
+type Rule interface {
+	// ID returns the stable rule code, eg. "ARG.001".
+	ID() string
+	// Severity is the default severity reported by this rule.
+	Severity() Severity
+	// Visit inspects in and appends findings to the returned slice.
+	Visit(in Input) []Finding
+}
+
+var (
+	_registryMu sync.RWMutex
+	_registry   = make(map[string]Rule)
+)
+
+// Register adds rule to the global registry. It panics if a rule with the
+// same ID was already registered — this mirrors driver.Register-style
+// init-time registration used elsewhere in arana.
+func Register(rule Rule) {
+	_registryMu.Lock()
+	defer _registryMu.Unlock()
+
+	id := rule.ID()
+	if _, ok := _registry[id]; ok {
+		panic(errors.Errorf("advisor: rule %s already registered", id))
+	}
+	_registry[id] = rule
+}
+
+// Lookup returns the rule registered under id, if any.
+func Lookup(id string) (Rule, bool) {
+	_registryMu.RLock()
+	defer _registryMu.RUnlock()
+	rule, ok := _registry[id]
+	return rule, ok
+}
+
+// Rules returns every registered rule sorted by ID, for deterministic
+// iteration order.
+func Rules() []Rule {
+	_registryMu.RLock()
+	defer _registryMu.RUnlock()
+
+	out := make([]Rule, 0, len(_registry))
+	for _, rule := range _registry {
+		out = append(out, rule)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ID() < out[j].ID()
+	})
+	return out
+}
+
+// Advise runs every rule enabled by cfg against stmt's extracted Input and
+// returns the aggregated, ID-sorted findings. isSharded reports whether a
+// physical table name is sharded; the advisor package has no sharding
+// metadata of its own, so the planner (which does) supplies it. isSharded
+// may be nil, in which case sharding-aware rules simply find nothing.
+//
+// Like whereClause/havingClause/onClause below, wildcardProjection and
+// fromTables are satisfied by duck-typing against whatever *ast.SelectStatement
+// grows those accessors; this snapshot's ast package doesn't implement any of
+// the five yet, so until it does, Advise only ever sees empty Input and every
+// rule — not just selectStarOnShardedTableRule — finds nothing.
+func Advise(stmt ast.Statement, cfg *Config, isSharded func(table string) bool) []Finding {
+	in := Input{Conds: ConditionsOf(stmt)}
+	if w, ok := stmt.(wildcardProjection); ok {
+		in.Wildcard = w.IsWildcard()
+	}
+	if f, ok := stmt.(fromTables); ok && isSharded != nil {
+		for _, table := range f.FromTables() {
+			if isSharded(table) {
+				in.ShardedFromTables = append(in.ShardedFromTables, table)
+			}
+		}
+	}
+
+	var findings []Finding
+	for _, rule := range Rules() {
+		if cfg != nil && cfg.Disabled(rule.ID()) {
+			continue
+		}
+		findings = append(findings, rule.Visit(in)...)
+	}
+
+	sort.Slice(findings, func(i, j int) bool {
+		return findings[i].RuleID < findings[j].RuleID
+	})
+
+	return findings
+}
+
+// whereClause, havingClause and onClause are checked individually —
+// rather than requiring one combined interface — because not every
+// statement exposes all three, and a SELECT's ON predicates live on its
+// table sources rather than on the statement itself.
+type whereClause interface {
+	Where() ast.PredicateNode
+}
+
+type havingClause interface {
+	Having() ast.PredicateNode
+}
+
+type onClause interface {
+	On() ast.PredicateNode
+}
+
+// wildcardProjection is implemented by a SELECT whose projection can
+// report whether it is the bare `*` form.
+type wildcardProjection interface {
+	IsWildcard() bool
+}
+
+// fromTables is implemented by a statement that can report the physical
+// tables named in its FROM clause.
+type fromTables interface {
+	FromTables() []string
+}
+
+// ConditionsOf extracts every WHERE/HAVING/ON predicate stmt exposes. A
+// statement that implements none of whereClause, havingClause or onClause
+// (eg. INSERT VALUES with no subquery) simply contributes no conditions —
+// that is the correct, non-silent behavior for such statements, not a
+// fallback for an unrecognized shape.
+func ConditionsOf(stmt ast.Statement) []ast.PredicateNode {
+	var conds []ast.PredicateNode
+	if w, ok := stmt.(whereClause); ok {
+		if cond := w.Where(); cond != nil {
+			conds = append(conds, cond)
+		}
+	}
+	if h, ok := stmt.(havingClause); ok {
+		if cond := h.Having(); cond != nil {
+			conds = append(conds, cond)
+		}
+	}
+	if o, ok := stmt.(onClause); ok {
+		if cond := o.On(); cond != nil {
+			conds = append(conds, cond)
+		}
+	}
+	return conds
+}
@@ -0,0 +1,44 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package advisor
+
+// Config controls which advisor rules are active. Its yaml/json tags
+// describe the `advisor` section of the bootstrap configuration, eg:
+//
+//	advisor:
+//	  disabled_rules: [ "KWR.004" ]
+//
+// This package does not read the bootstrap configuration itself; Advise
+// takes an already-decoded Config.
+type Config struct {
+	// DisabledRules lists rule IDs that must not run, eg. "ARG.001".
+	DisabledRules []string `yaml:"disabled_rules" json:"disabled_rules"`
+}
+
+// Disabled reports whether ruleID has been turned off by this config.
+func (c *Config) Disabled(ruleID string) bool {
+	if c == nil {
+		return false
+	}
+	for _, id := range c.DisabledRules {
+		if id == ruleID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,290 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package advisor
+
+import (
+	"strings"
+)
+
+import (
+	"github.com/arana-db/arana/pkg/runtime/ast"
+)
+
+func init() {
+	Register(&alwaysTrueFalseRule{})
+	Register(&implicitConversionRule{})
+	Register(&leadingWildcardLikeRule{})
+	Register(&nonSargableFunctionRule{})
+	Register(&orAcrossColumnsRule{})
+	Register(&selectStarOnShardedTableRule{})
+}
+
+// alwaysTrueFalseRule reports `WHERE <const> <op> <const>` predicates,
+// which always evaluate to the same boolean and usually indicate a typo.
+type alwaysTrueFalseRule struct{}
+
+func (r *alwaysTrueFalseRule) ID() string         { return "ARG.001" }
+func (r *alwaysTrueFalseRule) Severity() Severity { return SeverityWarning }
+
+func (r *alwaysTrueFalseRule) Visit(in Input) []Finding {
+	var findings []Finding
+	visitComparisons(in.Conds, func(cmp *ast.BinaryComparisonPredicateNode) {
+		left, right, ok := WalkComparison(cmp)
+		if !ok {
+			return
+		}
+		lc, lok := left.(*ast.ConstantExpressionAtom)
+		rc, rok := right.(*ast.ConstantExpressionAtom)
+		if !lok || !rok {
+			return
+		}
+		findings = append(findings, Finding{
+			RuleID:     r.ID(),
+			Severity:   r.Severity(),
+			Position:   lc.String() + " vs " + rc.String(),
+			Message:    "predicate compares two constants (" + lc.String() + " vs " + rc.String() + ") and is always true or always false",
+			Suggestion: "remove the predicate or replace one side with a real column reference",
+		})
+	})
+	return findings
+}
+
+// implicitConversionRule reports a column compared against a numeric or
+// boolean constant: when the column is VARCHAR/CHAR, MySQL casts the column
+// and silently drops the index. The column's declared type is not checked
+// (no schema metadata is available here), so the comparison is reported
+// whatever the column's type is.
+type implicitConversionRule struct{}
+
+func (r *implicitConversionRule) ID() string         { return "ARG.002" }
+func (r *implicitConversionRule) Severity() Severity { return SeverityWarning }
+
+func (r *implicitConversionRule) Visit(in Input) []Finding {
+	var findings []Finding
+	visitComparisons(in.Conds, func(cmp *ast.BinaryComparisonPredicateNode) {
+		left, right, ok := WalkComparison(cmp)
+		if !ok {
+			return
+		}
+
+		col, constant := asColumnAndConstant(left, right)
+		if col == nil || constant == nil {
+			return
+		}
+
+		if _, isString := constant.Value().(string); isString {
+			// A string literal against a column is only suspicious if the
+			// column is numeric, which we cannot know without schema
+			// metadata, so this rule only flags the inverse and more common
+			// case below (numeric literal against what is very likely a
+			// string/enum column name, eg. status = 1).
+			return
+		}
+
+		switch constant.Value().(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, bool:
+			findings = append(findings, Finding{
+				RuleID:     r.ID(),
+				Severity:   r.Severity(),
+				Position:   col.String(),
+				Message:    "column `" + col.String() + "` is compared against a non-string constant; if the column is VARCHAR/CHAR this triggers an implicit conversion and disables the index",
+				Suggestion: "quote the literal to match the column's declared type",
+			})
+		}
+	})
+	return findings
+}
+
+func asColumnAndConstant(left, right ast.ExpressionAtom) (*ast.ColumnNameExpressionAtom, *ast.ConstantExpressionAtom) {
+	if col, ok := left.(ast.ColumnNameExpressionAtom); ok {
+		if cst, ok := right.(*ast.ConstantExpressionAtom); ok {
+			return &col, cst
+		}
+	}
+	if col, ok := right.(ast.ColumnNameExpressionAtom); ok {
+		if cst, ok := left.(*ast.ConstantExpressionAtom); ok {
+			return &col, cst
+		}
+	}
+	return nil, nil
+}
+
+// leadingWildcardLikeRule reports `LIKE '%...'` patterns, which cannot use a
+// B-tree index and force a full scan.
+type leadingWildcardLikeRule struct{}
+
+func (r *leadingWildcardLikeRule) ID() string         { return "KWR.004" }
+func (r *leadingWildcardLikeRule) Severity() Severity { return SeverityWarning }
+
+func (r *leadingWildcardLikeRule) Visit(in Input) []Finding {
+	var findings []Finding
+	for _, cond := range in.Conds {
+		WalkPredicate(cond, func(p ast.PredicateNode) {
+			like, ok := p.(*ast.LikePredicateNode)
+			if !ok {
+				return
+			}
+			pattern, ok := like.Right.(*ast.ConstantExpressionAtom)
+			if !ok {
+				return
+			}
+			str, ok := pattern.Value().(string)
+			if !ok || len(str) == 0 || str[0] != '%' {
+				return
+			}
+			position := pattern.String()
+			if col, ok := like.Left.(ast.ColumnNameExpressionAtom); ok {
+				position = col.String() + " LIKE " + pattern.String()
+			}
+			findings = append(findings, Finding{
+				RuleID:     r.ID(),
+				Severity:   r.Severity(),
+				Position:   position,
+				Message:    "LIKE pattern " + pattern.String() + " starts with a wildcard and cannot use an index",
+				Suggestion: "avoid a leading '%' or switch to a full-text index",
+			})
+		})
+	}
+	return findings
+}
+
+// nonSargableFunctionRule reports a function call wrapping a column
+// reference directly (eg. `YEAR(created_at) = 2024`), which prevents MySQL
+// from using an index on that column.
+type nonSargableFunctionRule struct{}
+
+func (r *nonSargableFunctionRule) ID() string         { return "KEY.010" }
+func (r *nonSargableFunctionRule) Severity() Severity { return SeverityWarning }
+
+func (r *nonSargableFunctionRule) Visit(in Input) []Finding {
+	var findings []Finding
+	visitComparisons(in.Conds, func(cmp *ast.BinaryComparisonPredicateNode) {
+		left, right, ok := WalkComparison(cmp)
+		if !ok {
+			return
+		}
+		for _, side := range [2]ast.ExpressionAtom{left, right} {
+			fn, ok := side.(*ast.FunctionCallExpressionAtom)
+			if !ok {
+				continue
+			}
+			var wrappedColumn string
+			ast.WalkAtom(fn, func(a ast.ExpressionAtom) bool {
+				if col, ok := a.(ast.ColumnNameExpressionAtom); ok {
+					wrappedColumn = col.String()
+					return false
+				}
+				return true
+			})
+			if wrappedColumn != "" {
+				findings = append(findings, Finding{
+					RuleID:     r.ID(),
+					Severity:   r.Severity(),
+					Position:   wrappedColumn,
+					Message:    "a function wraps a column reference inside a comparison, making the predicate non-sargable",
+					Suggestion: "rewrite the predicate so the column is compared directly, eg. move the function to the constant side",
+				})
+			}
+		}
+	})
+	return findings
+}
+
+// orAcrossColumnsRule reports `OR` clauses joining predicates over
+// different columns, which usually defeat composite indexes and are better
+// expressed with UNION or an IN list.
+type orAcrossColumnsRule struct{}
+
+func (r *orAcrossColumnsRule) ID() string         { return "KEY.011" }
+func (r *orAcrossColumnsRule) Severity() Severity { return SeverityInfo }
+
+func (r *orAcrossColumnsRule) Visit(in Input) []Finding {
+	var findings []Finding
+	for _, cond := range in.Conds {
+		WalkPredicate(cond, func(p ast.PredicateNode) {
+			or, ok := p.(*ast.LogicalOrPredicateNode)
+			if !ok {
+				return
+			}
+			columns := distinctColumns(or.Predicates)
+			if len(columns) > 1 {
+				findings = append(findings, Finding{
+					RuleID:     r.ID(),
+					Severity:   r.Severity(),
+					Position:   strings.Join(columns, ", "),
+					Message:    "OR clause joins predicates over different columns, which defeats composite indexes",
+					Suggestion: "consider rewriting as UNION ALL of single-column predicates, or collapse into an IN list",
+				})
+			}
+		})
+	}
+	return findings
+}
+
+// distinctColumns returns every distinct column referenced across branches,
+// in first-seen order.
+func distinctColumns(branches []ast.PredicateNode) []string {
+	seen := make(map[string]struct{})
+	var out []string
+	for _, branch := range branches {
+		for _, col := range ColumnsOf(branch) {
+			if _, ok := seen[col]; ok {
+				continue
+			}
+			seen[col] = struct{}{}
+			out = append(out, col)
+		}
+	}
+	return out
+}
+
+// selectStarOnShardedTableRule reports a `SELECT *` that reads from a
+// sharded table: the fan-out has to scatter to and gather from every
+// shard, and an unplanned column (eg. added by a migration run against
+// one shard before the others) silently changes the result shape.
+type selectStarOnShardedTableRule struct{}
+
+func (r *selectStarOnShardedTableRule) ID() string         { return "ARG.012" }
+func (r *selectStarOnShardedTableRule) Severity() Severity { return SeverityWarning }
+
+func (r *selectStarOnShardedTableRule) Visit(in Input) []Finding {
+	if !in.Wildcard || len(in.ShardedFromTables) == 0 {
+		return nil
+	}
+	return []Finding{{
+		RuleID:     r.ID(),
+		Severity:   r.Severity(),
+		Position:   strings.Join(in.ShardedFromTables, ", "),
+		Message:    "SELECT * reads from sharded table(s) " + strings.Join(in.ShardedFromTables, ", ") + ", fanning out to every shard for columns the caller may not need",
+		Suggestion: "list only the needed columns instead of *",
+	}}
+}
+
+// visitComparisons walks every BinaryComparisonPredicateNode reachable from
+// conds and invokes fn for each. conds is the set of WHERE/HAVING/ON
+// predicates that Advise extracted from the statement being advised through
+// ConditionsOf (Input.Conds).
+func visitComparisons(conds []ast.PredicateNode, fn func(*ast.BinaryComparisonPredicateNode)) {
+	for _, cond := range conds {
+		WalkPredicate(cond, func(p ast.PredicateNode) {
+			if cmp, ok := p.(*ast.BinaryComparisonPredicateNode); ok {
+				fn(cmp)
+			}
+		})
+	}
+}
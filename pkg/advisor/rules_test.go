@@ -0,0 +1,239 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package advisor
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/arana-db/arana/pkg/runtime/ast"
+)
+
+func atomPredicate(atom ast.ExpressionAtom) ast.PredicateNode {
+	return &ast.AtomPredicateNode{A: atom}
+}
+
+func column(name string) ast.ColumnNameExpressionAtom {
+	return ast.NewSingleColumnNameExpressionAtom(name)
+}
+
+func constant(v interface{}) *ast.ConstantExpressionAtom {
+	return &ast.ConstantExpressionAtom{Inner: v}
+}
+
+func cmp(left, right ast.ExpressionAtom) *ast.BinaryComparisonPredicateNode {
+	return &ast.BinaryComparisonPredicateNode{
+		Left:  atomPredicate(left),
+		Right: atomPredicate(right),
+	}
+}
+
+func inputOf(conds ...ast.PredicateNode) Input {
+	return Input{Conds: conds}
+}
+
+func TestAlwaysTrueFalseRule(t *testing.T) {
+	rule := &alwaysTrueFalseRule{}
+
+	cases := []struct {
+		name string
+		cond ast.PredicateNode
+		want int
+	}{
+		{"const_vs_const", cmp(constant(1), constant(1)), 1},
+		{"column_vs_const", cmp(column("id"), constant(1)), 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := rule.Visit(inputOf(tc.cond))
+			if len(got) != tc.want {
+				t.Fatalf("expected %d findings, got %d: %+v", tc.want, len(got), got)
+			}
+			if tc.want > 0 && got[0].Position == "" {
+				t.Fatalf("expected a non-empty Position, got %+v", got[0])
+			}
+		})
+	}
+}
+
+func TestImplicitConversionRule(t *testing.T) {
+	rule := &implicitConversionRule{}
+
+	cases := []struct {
+		name string
+		cond ast.PredicateNode
+		want int
+	}{
+		{"numeric_literal_against_column", cmp(column("status"), constant(1)), 1},
+		{"string_literal_against_column", cmp(column("status"), constant("1")), 0},
+		{"column_vs_column", cmp(column("a"), column("b")), 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := rule.Visit(inputOf(tc.cond))
+			if len(got) != tc.want {
+				t.Fatalf("expected %d findings, got %d: %+v", tc.want, len(got), got)
+			}
+			if tc.want > 0 && got[0].Position != "status" {
+				t.Fatalf("expected Position %q, got %q", "status", got[0].Position)
+			}
+		})
+	}
+}
+
+func TestLeadingWildcardLikeRule(t *testing.T) {
+	rule := &leadingWildcardLikeRule{}
+
+	cases := []struct {
+		name string
+		cond ast.PredicateNode
+		want int
+	}{
+		{
+			"leading_wildcard",
+			&ast.LikePredicateNode{Left: column("name"), Right: constant("%smith")},
+			1,
+		},
+		{
+			"trailing_wildcard_only",
+			&ast.LikePredicateNode{Left: column("name"), Right: constant("smith%")},
+			0,
+		},
+		{
+			// Regression test: a column/table literally named "dislikes"
+			// must never trip this rule via substring matching on restored
+			// SQL text — the rule only looks at parsed LikePredicateNode
+			// patterns.
+			"unrelated_column_name_containing_like",
+			cmp(column("dislikes"), constant(1)),
+			0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := rule.Visit(inputOf(tc.cond))
+			if len(got) != tc.want {
+				t.Fatalf("expected %d findings, got %d: %+v", tc.want, len(got), got)
+			}
+			if tc.want > 0 && got[0].Position == "" {
+				t.Fatalf("expected a non-empty Position, got %+v", got[0])
+			}
+		})
+	}
+}
+
+// fakeFunc is a minimal stand-in for *ast.Function et al.: it only needs to
+// expose its arguments for WalkAtom's ArgumentedFunc case to descend into.
+type fakeFunc struct {
+	args []ast.ExpressionAtom
+}
+
+func (f *fakeFunc) Args() []ast.ExpressionAtom { return f.args }
+
+func TestNonSargableFunctionRule(t *testing.T) {
+	rule := &nonSargableFunctionRule{}
+
+	wrapsColumn := &ast.FunctionCallExpressionAtom{F: &fakeFunc{args: []ast.ExpressionAtom{column("created_at")}}}
+	wrapsConstant := &ast.FunctionCallExpressionAtom{F: &fakeFunc{args: []ast.ExpressionAtom{constant("x")}}}
+
+	cases := []struct {
+		name string
+		cond ast.PredicateNode
+		want int
+	}{
+		{"function_wraps_column", cmp(wrapsColumn, constant(2024)), 1},
+		{"function_wraps_constant_only", cmp(wrapsConstant, constant(2024)), 0},
+		{"no_function_involved", cmp(column("created_at"), constant(2024)), 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := rule.Visit(inputOf(tc.cond))
+			if len(got) != tc.want {
+				t.Fatalf("expected %d findings, got %d: %+v", tc.want, len(got), got)
+			}
+			if tc.want > 0 && got[0].Position != "created_at" {
+				t.Fatalf("expected Position %q, got %q", "created_at", got[0].Position)
+			}
+		})
+	}
+}
+
+func TestOrAcrossColumnsRule(t *testing.T) {
+	rule := &orAcrossColumnsRule{}
+
+	sameColumn := &ast.LogicalOrPredicateNode{Predicates: []ast.PredicateNode{
+		cmp(column("id"), constant(1)),
+		cmp(column("id"), constant(2)),
+	}}
+	differentColumns := &ast.LogicalOrPredicateNode{Predicates: []ast.PredicateNode{
+		cmp(column("id"), constant(1)),
+		cmp(column("name"), constant("x")),
+	}}
+
+	cases := []struct {
+		name string
+		cond ast.PredicateNode
+		want int
+	}{
+		{"same_column_or", sameColumn, 0},
+		{"different_column_or", differentColumns, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := rule.Visit(inputOf(tc.cond))
+			if len(got) != tc.want {
+				t.Fatalf("expected %d findings, got %d: %+v", tc.want, len(got), got)
+			}
+			if tc.want > 0 && got[0].Position != "id, name" {
+				t.Fatalf("expected Position %q, got %q", "id, name", got[0].Position)
+			}
+		})
+	}
+}
+
+func TestSelectStarOnShardedTableRule(t *testing.T) {
+	rule := &selectStarOnShardedTableRule{}
+
+	cases := []struct {
+		name string
+		in   Input
+		want int
+	}{
+		{"wildcard_on_sharded_table", Input{Wildcard: true, ShardedFromTables: []string{"orders"}}, 1},
+		{"not_wildcard", Input{Wildcard: false, ShardedFromTables: []string{"orders"}}, 0},
+		{"wildcard_no_sharded_tables", Input{Wildcard: true}, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := rule.Visit(tc.in)
+			if len(got) != tc.want {
+				t.Fatalf("expected %d findings, got %d: %+v", tc.want, len(got), got)
+			}
+			if tc.want > 0 && got[0].Position != "orders" {
+				t.Fatalf("expected Position %q, got %q", "orders", got[0].Position)
+			}
+		})
+	}
+}
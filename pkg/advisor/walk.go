@@ -0,0 +1,103 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package advisor
+
+import (
+	"github.com/arana-db/arana/pkg/runtime/ast"
+)
+
+// WalkComparison extracts the two operands of a binary comparison predicate
+// as ExpressionAtoms, when both sides are simple atoms (as opposed to
+// nested predicates or sub-selects). It returns ok=false otherwise.
+func WalkComparison(cmp *ast.BinaryComparisonPredicateNode) (left, right ast.ExpressionAtom, ok bool) {
+	lp, lok := cmp.Left.(*ast.AtomPredicateNode)
+	rp, rok := cmp.Right.(*ast.AtomPredicateNode)
+	if !lok || !rok {
+		return nil, nil, false
+	}
+	la, laok := lp.A.(ast.ExpressionAtom)
+	ra, raok := rp.A.(ast.ExpressionAtom)
+	if !laok || !raok {
+		return nil, nil, false
+	}
+	return la, ra, true
+}
+
+// WalkPredicate recurses into node and every PredicateNode reachable from
+// it through AND/OR conjunctions, invoking fn for each node (including the
+// AND/OR nodes themselves) in pre-order.
+func WalkPredicate(node ast.PredicateNode, fn func(ast.PredicateNode)) {
+	if node == nil {
+		return
+	}
+	fn(node)
+
+	switch v := node.(type) {
+	case *ast.LogicalAndPredicateNode:
+		for _, p := range v.Predicates {
+			WalkPredicate(p, fn)
+		}
+	case *ast.LogicalOrPredicateNode:
+		for _, p := range v.Predicates {
+			WalkPredicate(p, fn)
+		}
+	}
+}
+
+// ColumnsOf collects every distinct column reference reachable from node,
+// as `table.column`-or-`column` strings produced by ColumnNameExpressionAtom.String.
+func ColumnsOf(node ast.PredicateNode) []string {
+	seen := make(map[string]struct{})
+	var out []string
+	add := func(col ast.ColumnNameExpressionAtom) {
+		name := col.String()
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		out = append(out, name)
+	}
+
+	collectAtom := func(atom ast.ExpressionAtom) {
+		ast.WalkAtom(atom, func(a ast.ExpressionAtom) bool {
+			if col, ok := a.(ast.ColumnNameExpressionAtom); ok {
+				add(col)
+			}
+			return true
+		})
+	}
+
+	WalkPredicate(node, func(p ast.PredicateNode) {
+		switch v := p.(type) {
+		case *ast.BinaryComparisonPredicateNode:
+			if left, right, ok := WalkComparison(v); ok {
+				collectAtom(left)
+				collectAtom(right)
+			}
+		case *ast.LikePredicateNode:
+			collectAtom(v.Left)
+			collectAtom(v.Right)
+		case *ast.AtomPredicateNode:
+			if atom, ok := v.A.(ast.ExpressionAtom); ok {
+				collectAtom(atom)
+			}
+		}
+	})
+
+	return out
+}
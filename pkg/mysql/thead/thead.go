@@ -45,6 +45,26 @@ var (
 	Users = Thead{
 		Col{Name: "user_name", FieldType: consts.FieldTypeVarString},
 	}
+	Advisor = Thead{
+		Col{Name: "rule_id", FieldType: consts.FieldTypeVarString},
+		Col{Name: "severity", FieldType: consts.FieldTypeVarString},
+		Col{Name: "position", FieldType: consts.FieldTypeVarString},
+		Col{Name: "message", FieldType: consts.FieldTypeVarString},
+		Col{Name: "suggestion", FieldType: consts.FieldTypeVarString},
+	}
+	Cache = Thead{
+		Col{Name: "sql_fingerprint", FieldType: consts.FieldTypeVarString},
+		Col{Name: "hit_count", FieldType: consts.FieldTypeLongLong},
+		Col{Name: "miss_count", FieldType: consts.FieldTypeLongLong},
+		Col{Name: "size_bytes", FieldType: consts.FieldTypeLongLong},
+		Col{Name: "dependencies", FieldType: consts.FieldTypeVarString},
+	}
+	Listeners = Thead{
+		Col{Name: "listener", FieldType: consts.FieldTypeVarString},
+		Col{Name: "connection_id", FieldType: consts.FieldTypeLongLong},
+		Col{Name: "compression_codec", FieldType: consts.FieldTypeVarString},
+		Col{Name: "compression_level", FieldType: consts.FieldTypeLong},
+	}
 )
 
 type Col struct {
@@ -0,0 +1,123 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package cache memoizes the result sets of deterministic, read-only
+// queries against upstream MySQL and invalidates them as soon as a DML/DDL
+// statement touches a table they depend on.
+//
+// This package only holds the mechanism — the planner is expected to wire
+// it into the execution path: on a SELECT, gather its FROM tables and
+// ExpressionAtoms, check Cacheable, derive a Key via NewKey and consult
+// Store.Get before going to the upstream connection, then Store.Set the
+// result tagged with Dependencies; on any DML/DDL, call Store.Evict with
+// the tables it writes to. An admin `SHOW CACHE STATUS` handler would
+// render Store.Stats through thead.Cache, and a bootstrap config loader
+// would decode a logical database's `cache:` section into cache.Config.
+//
+// None of those three callers — the executor's SELECT/DML path, the admin
+// command dispatcher, the bootstrap config loader — exist in this package
+// set yet, so this package is not reachable from a running proxy; it is
+// the mechanism those future changes wire up, not a complete feature on
+// its own.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Key uniquely identifies a cached result set: the canonical restored SQL
+// plus its argument vector.
+type Key string
+
+// NewKey derives a Key from the canonical (restored) SQL text and its bound
+// argument values.
+func NewKey(logicalDB, sql string, args []interface{}) Key {
+	var sb strings.Builder
+	sb.WriteString(logicalDB)
+	sb.WriteByte('\x00')
+	sb.WriteString(sql)
+	for _, arg := range args {
+		sb.WriteByte('\x00')
+		sb.WriteString(toArgString(arg))
+	}
+
+	sum := sha256.Sum256([]byte(sb.String()))
+	return Key(hex.EncodeToString(sum[:]))
+}
+
+func toArgString(arg interface{}) string {
+	switch v := arg.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return "<nil>"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// Entry is a single cached result set alongside the bookkeeping needed to
+// report SHOW CACHE STATUS and to invalidate it.
+type Entry struct {
+	Key Key
+	// Fingerprint is the canonical SQL this entry was computed from.
+	Fingerprint string
+	// Dependencies are the physical tables this entry must be evicted for.
+	Dependencies []string
+	// Value is the opaque, already-serialized result set.
+	Value []byte
+	// ExpiresAt is zero when the entry has no TTL.
+	ExpiresAt time.Time
+}
+
+func (e *Entry) expired(now time.Time) bool {
+	return !e.ExpiresAt.IsZero() && now.After(e.ExpiresAt)
+}
+
+// Store is a pluggable backing store for cached Entries. Implementations
+// must be safe for concurrent use.
+type Store interface {
+	// Get returns the entry for key, if present and not expired.
+	Get(key Key) (*Entry, bool)
+	// Set stores entry, replacing any previous value under the same key.
+	Set(entry *Entry)
+	// Evict removes every entry that depends on any of the given tables.
+	Evict(tables []string)
+	// Stats reports cumulative hit/miss counters and the current footprint.
+	Stats() Stats
+}
+
+// Stats summarizes cache activity for SHOW CACHE STATUS / thead.Cache.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Entries   int
+	SizeBytes int64
+}
@@ -0,0 +1,66 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewKey_DeterministicForSameInput(t *testing.T) {
+	k1 := NewKey("db1", "SELECT * FROM t WHERE id = ?", []interface{}{int64(1)})
+	k2 := NewKey("db1", "SELECT * FROM t WHERE id = ?", []interface{}{int64(1)})
+	if k1 != k2 {
+		t.Fatalf("expected identical keys for identical input, got %q and %q", k1, k2)
+	}
+}
+
+func TestNewKey_DiffersByLogicalDB(t *testing.T) {
+	k1 := NewKey("db1", "SELECT 1", nil)
+	k2 := NewKey("db2", "SELECT 1", nil)
+	if k1 == k2 {
+		t.Fatal("expected different logical databases to produce different keys")
+	}
+}
+
+func TestNewKey_DiffersByArgs(t *testing.T) {
+	k1 := NewKey("db1", "SELECT * FROM t WHERE id = ?", []interface{}{int64(1)})
+	k2 := NewKey("db1", "SELECT * FROM t WHERE id = ?", []interface{}{int64(2)})
+	if k1 == k2 {
+		t.Fatal("expected different arguments to produce different keys")
+	}
+}
+
+func TestEntry_Expired(t *testing.T) {
+	now := time.Now()
+
+	noTTL := &Entry{}
+	if noTTL.expired(now) {
+		t.Fatal("an entry with a zero ExpiresAt should never expire")
+	}
+
+	future := &Entry{ExpiresAt: now.Add(time.Hour)}
+	if future.expired(now) {
+		t.Fatal("an entry expiring in the future should not be expired yet")
+	}
+
+	past := &Entry{ExpiresAt: now.Add(-time.Hour)}
+	if !past.expired(now) {
+		t.Fatal("an entry that expired an hour ago should be expired")
+	}
+}
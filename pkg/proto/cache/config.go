@@ -0,0 +1,71 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"time"
+)
+
+// Backend selects which Store implementation to build from a Config.
+type Backend string
+
+const (
+	// BackendLRU is the default in-process store.
+	BackendLRU Backend = "lru"
+	// BackendRedis shares cached entries across proxy instances.
+	BackendRedis Backend = "redis"
+)
+
+// Config describes, through its yaml/json tags, the `cache` section of a
+// logical database's bootstrap configuration, eg:
+//
+//	cache:
+//	  enabled: true
+//	  backend: lru
+//	  max_entries: 10000
+//	  max_size_bytes: 134217728
+//	  ttl: 30s
+//
+// This package does not read the bootstrap configuration itself; NewStore
+// takes an already-decoded Config.
+type Config struct {
+	Enabled      bool          `yaml:"enabled" json:"enabled"`
+	Backend      Backend       `yaml:"backend" json:"backend"`
+	MaxEntries   int           `yaml:"max_entries" json:"max_entries"`
+	MaxSizeBytes int64         `yaml:"max_size_bytes" json:"max_size_bytes"`
+	TTL          time.Duration `yaml:"ttl" json:"ttl"`
+	// RedisAddr is never read by this package: NewStore takes an
+	// already-dialed RedisClient so this package stays driver-agnostic and
+	// testable (see RedisClient's doc comment). Whatever decodes a logical
+	// database's bootstrap config into a Config is responsible for reading
+	// RedisAddr and dialing a client from it before calling NewStore.
+	RedisAddr string `yaml:"redis_addr" json:"redis_addr"`
+}
+
+// NewStore builds the Store described by cfg. It never returns nil: an
+// unrecognized or zero-value Backend falls back to an in-process LRUStore.
+func NewStore(cfg Config, client RedisClient) Store {
+	if cfg.Backend == BackendRedis && client != nil {
+		return NewRedisStore(client, cfg.TTL)
+	}
+	return NewLRUStore(LRUConfig{
+		MaxEntries:   cfg.MaxEntries,
+		MaxSizeBytes: cfg.MaxSizeBytes,
+		TTL:          cfg.TTL,
+	})
+}
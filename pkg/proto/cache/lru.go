@@ -0,0 +1,171 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LRUConfig configures an in-process LRUStore.
+type LRUConfig struct {
+	// MaxEntries caps the number of cached result sets; zero means
+	// unlimited.
+	MaxEntries int
+	// MaxSizeBytes caps the total size of cached Values; zero means
+	// unlimited.
+	MaxSizeBytes int64
+	// TTL is applied to every entry that doesn't already carry one; zero
+	// means entries never expire on their own.
+	TTL time.Duration
+}
+
+// LRUStore is the default, in-process Store. It evicts the least recently
+// used entry once MaxEntries or MaxSizeBytes is exceeded, and additionally
+// maintains an inverse table -> keys index so DML/DDL can invalidate by
+// table name in O(1) per dependency.
+type LRUStore struct {
+	cfg LRUConfig
+
+	mu      sync.Mutex
+	ll      *list.List // of *Entry, most-recently-used at the front
+	index   map[Key]*list.Element
+	byTable map[string]map[Key]struct{}
+	size    int64
+
+	hits   uint64
+	misses uint64
+}
+
+// NewLRUStore builds an LRUStore from cfg.
+func NewLRUStore(cfg LRUConfig) *LRUStore {
+	return &LRUStore{
+		cfg:     cfg,
+		ll:      list.New(),
+		index:   make(map[Key]*list.Element),
+		byTable: make(map[string]map[Key]struct{}),
+	}
+}
+
+func (s *LRUStore) Get(key Key) (*Entry, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.index[key]
+	if !ok {
+		atomic.AddUint64(&s.misses, 1)
+		return nil, false
+	}
+
+	entry := el.Value.(*Entry)
+	if entry.expired(time.Now()) {
+		s.removeLocked(el)
+		atomic.AddUint64(&s.misses, 1)
+		return nil, false
+	}
+
+	s.ll.MoveToFront(el)
+	atomic.AddUint64(&s.hits, 1)
+	return entry, true
+}
+
+func (s *LRUStore) Set(entry *Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if entry.ExpiresAt.IsZero() && s.cfg.TTL > 0 {
+		entry.ExpiresAt = time.Now().Add(s.cfg.TTL)
+	}
+
+	if el, ok := s.index[entry.Key]; ok {
+		s.removeLocked(el)
+	}
+
+	el := s.ll.PushFront(entry)
+	s.index[entry.Key] = el
+	s.size += int64(len(entry.Value))
+	for _, table := range entry.Dependencies {
+		keys, ok := s.byTable[table]
+		if !ok {
+			keys = make(map[Key]struct{})
+			s.byTable[table] = keys
+		}
+		keys[entry.Key] = struct{}{}
+	}
+
+	s.evictLocked()
+}
+
+func (s *LRUStore) Evict(tables []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[Key]struct{})
+	for _, table := range tables {
+		for key := range s.byTable[table] {
+			seen[key] = struct{}{}
+		}
+	}
+	for key := range seen {
+		if el, ok := s.index[key]; ok {
+			s.removeLocked(el)
+		}
+	}
+}
+
+func (s *LRUStore) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return Stats{
+		Hits:      atomic.LoadUint64(&s.hits),
+		Misses:    atomic.LoadUint64(&s.misses),
+		Entries:   s.ll.Len(),
+		SizeBytes: s.size,
+	}
+}
+
+// evictLocked drops least-recently-used entries until the store is back
+// within its configured limits. Callers must hold s.mu.
+func (s *LRUStore) evictLocked() {
+	for (s.cfg.MaxEntries > 0 && s.ll.Len() > s.cfg.MaxEntries) ||
+		(s.cfg.MaxSizeBytes > 0 && s.size > s.cfg.MaxSizeBytes) {
+		back := s.ll.Back()
+		if back == nil {
+			return
+		}
+		s.removeLocked(back)
+	}
+}
+
+// removeLocked detaches el from every index. Callers must hold s.mu.
+func (s *LRUStore) removeLocked(el *list.Element) {
+	entry := el.Value.(*Entry)
+	s.ll.Remove(el)
+	delete(s.index, entry.Key)
+	s.size -= int64(len(entry.Value))
+	for _, table := range entry.Dependencies {
+		keys := s.byTable[table]
+		delete(keys, entry.Key)
+		if len(keys) == 0 {
+			delete(s.byTable, table)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLRUStore_GetSetMiss(t *testing.T) {
+	store := NewLRUStore(LRUConfig{})
+
+	if _, ok := store.Get("missing"); ok {
+		t.Fatal("expected a miss on an empty store")
+	}
+
+	store.Set(&Entry{Key: "k1", Value: []byte("v1")})
+	entry, ok := store.Get("k1")
+	if !ok {
+		t.Fatal("expected a hit after Set")
+	}
+	if string(entry.Value) != "v1" {
+		t.Fatalf("expected value %q, got %q", "v1", entry.Value)
+	}
+
+	stats := store.Stats()
+	if stats.Hits != 1 || stats.Misses != 1 {
+		t.Fatalf("expected 1 hit and 1 miss, got %+v", stats)
+	}
+}
+
+func TestLRUStore_EvictsLeastRecentlyUsedOnMaxEntries(t *testing.T) {
+	store := NewLRUStore(LRUConfig{MaxEntries: 2})
+
+	store.Set(&Entry{Key: "a", Value: []byte("1")})
+	store.Set(&Entry{Key: "b", Value: []byte("1")})
+
+	// touch "a" so "b" becomes the least recently used
+	store.Get("a")
+
+	store.Set(&Entry{Key: "c", Value: []byte("1")})
+
+	if _, ok := store.Get("b"); ok {
+		t.Fatal("expected \"b\" to have been evicted as the least recently used entry")
+	}
+	if _, ok := store.Get("a"); !ok {
+		t.Fatal("expected \"a\" to survive since it was touched more recently")
+	}
+	if _, ok := store.Get("c"); !ok {
+		t.Fatal("expected \"c\" to be present")
+	}
+}
+
+func TestLRUStore_EvictsOnMaxSizeBytes(t *testing.T) {
+	store := NewLRUStore(LRUConfig{MaxSizeBytes: 4})
+
+	store.Set(&Entry{Key: "a", Value: []byte("12")})
+	store.Set(&Entry{Key: "b", Value: []byte("12")})
+	// total so far: 4 bytes, at the cap
+	store.Set(&Entry{Key: "c", Value: []byte("12")})
+	// adding "c" pushes past the cap; "a" (least recently used) must go
+
+	if _, ok := store.Get("a"); ok {
+		t.Fatal("expected \"a\" to be evicted once the size cap was exceeded")
+	}
+	if _, ok := store.Get("c"); !ok {
+		t.Fatal("expected \"c\" to be present")
+	}
+}
+
+func TestLRUStore_ExpiresByTTL(t *testing.T) {
+	store := NewLRUStore(LRUConfig{})
+	store.Set(&Entry{Key: "a", Value: []byte("1"), ExpiresAt: time.Now().Add(-time.Second)})
+
+	if _, ok := store.Get("a"); ok {
+		t.Fatal("expected an already-expired entry to miss")
+	}
+}
+
+func TestLRUStore_EvictByTable(t *testing.T) {
+	store := NewLRUStore(LRUConfig{})
+
+	store.Set(&Entry{Key: "a", Dependencies: []string{"t1"}})
+	store.Set(&Entry{Key: "b", Dependencies: []string{"t2"}})
+	store.Set(&Entry{Key: "c", Dependencies: []string{"t1", "t2"}})
+
+	store.Evict([]string{"t1"})
+
+	if _, ok := store.Get("a"); ok {
+		t.Fatal("expected \"a\" (depends on t1) to be evicted")
+	}
+	if _, ok := store.Get("c"); ok {
+		t.Fatal("expected \"c\" (depends on t1 and t2) to be evicted")
+	}
+	if _, ok := store.Get("b"); !ok {
+		t.Fatal("expected \"b\" (depends only on t2) to survive")
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"strings"
+)
+
+import (
+	"github.com/arana-db/arana/pkg/runtime/ast"
+)
+
+// nonDeterministicFuncs lists built-in function names whose result depends
+// on something other than their arguments, so a statement calling them must
+// never be cached.
+var nonDeterministicFuncs = map[string]struct{}{
+	"NOW":            {},
+	"SYSDATE":        {},
+	"CURDATE":        {},
+	"CURTIME":        {},
+	"CURRENT_DATE":   {},
+	"CURRENT_TIME":   {},
+	"RAND":           {},
+	"UUID":           {},
+	"UUID_SHORT":     {},
+	"CONNECTION_ID":  {},
+	"LAST_INSERT_ID": {},
+	"SLEEP":          {},
+}
+
+// Cacheable reports whether a SELECT is a deterministic, read-only query
+// whose result may be memoized, given every ExpressionAtom reachable from
+// its projection, WHERE, GROUP BY, HAVING and ORDER BY clauses. The planner
+// — which alone knows how to walk a concrete *ast.SelectStatement's own
+// fields — is responsible for gathering atoms; this function only judges
+// determinism from what it's handed.
+func Cacheable(atoms []ast.ExpressionAtom) bool {
+	cacheable := true
+	for _, atom := range atoms {
+		if !cacheable {
+			break
+		}
+		ast.WalkAtom(atom, func(a ast.ExpressionAtom) bool {
+			switch v := a.(type) {
+			case *ast.SystemVariableExpressionAtom:
+				cacheable = false
+				return false
+			case ast.VariableExpressionAtom:
+				// Bound placeholders are fine — their value becomes part of
+				// the cache Key — but a raw user-defined variable reference
+				// (`@x`) is not something we can key on deterministically.
+				_ = v
+			case *ast.FunctionCallExpressionAtom:
+				if fn, ok := v.F.(nameFunc); ok {
+					if _, nonDet := nonDeterministicFuncs[strings.ToUpper(fn.Name())]; nonDet {
+						cacheable = false
+						return false
+					}
+				}
+			}
+			return cacheable
+		})
+	}
+	return cacheable
+}
+
+// nameFunc is satisfied by every concrete function-call node that exposes
+// its built-in name (*ast.Function does; aggregate/case/cast functions
+// don't represent a single named built-in and so are left alone).
+type nameFunc interface {
+	Name() string
+}
+
+// Dependencies returns every physical table a SELECT reads from, given the
+// table names gathered from its FROM clause (fromTables) plus every
+// ExpressionAtom reachable from its projection/WHERE/GROUP
+// BY/HAVING/ORDER BY clauses (atoms), whose qualified column references may
+// name additional tables via aliasing. DML/DDL statements that touch the
+// returned tables must evict cache entries keyed on them.
+func Dependencies(fromTables []string, atoms []ast.ExpressionAtom) []string {
+	seen := make(map[string]struct{})
+	var tables []string
+	addTable := func(name string) {
+		if name == "" {
+			return
+		}
+		if _, ok := seen[name]; ok {
+			return
+		}
+		seen[name] = struct{}{}
+		tables = append(tables, name)
+	}
+
+	for _, table := range fromTables {
+		addTable(table)
+	}
+
+	for _, atom := range atoms {
+		ast.WalkAtom(atom, func(a ast.ExpressionAtom) bool {
+			if col, ok := a.(ast.ColumnNameExpressionAtom); ok {
+				addTable(col.Prefix())
+			}
+			return true
+		})
+	}
+
+	return tables
+}
@@ -0,0 +1,109 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+import (
+	"github.com/arana-db/arana/pkg/runtime/ast"
+)
+
+type fakeNamedFunc struct{ name string }
+
+func (f *fakeNamedFunc) Name() string { return f.name }
+
+func TestCacheable(t *testing.T) {
+	column := func(name string) ast.ExpressionAtom {
+		return ast.NewSingleColumnNameExpressionAtom(name)
+	}
+
+	cases := []struct {
+		name  string
+		atoms []ast.ExpressionAtom
+		want  bool
+	}{
+		{"plain_column", []ast.ExpressionAtom{column("id")}, true},
+		{
+			"deterministic_function",
+			[]ast.ExpressionAtom{&ast.FunctionCallExpressionAtom{F: &fakeNamedFunc{name: "UPPER"}}},
+			true,
+		},
+		{
+			"non_deterministic_function",
+			[]ast.ExpressionAtom{&ast.FunctionCallExpressionAtom{F: &fakeNamedFunc{name: "NOW"}}},
+			false,
+		},
+		{
+			"non_deterministic_function_case_insensitive",
+			[]ast.ExpressionAtom{&ast.FunctionCallExpressionAtom{F: &fakeNamedFunc{name: "rand"}}},
+			false,
+		},
+		{
+			"system_variable",
+			[]ast.ExpressionAtom{&ast.SystemVariableExpressionAtom{Name: "version"}},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Cacheable(tc.atoms); got != tc.want {
+				t.Fatalf("Cacheable() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	col := func(parts ...string) ast.ExpressionAtom {
+		c := ast.ColumnNameExpressionAtom(parts)
+		return c
+	}
+
+	got := Dependencies(
+		[]string{"orders"},
+		[]ast.ExpressionAtom{col("customers", "id"), col("id")},
+	)
+
+	sort.Strings(got)
+	want := []string{"customers", "orders"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Dependencies() = %v, want %v", got, want)
+	}
+}
+
+func TestDependencies_DeduplicatesAndIgnoresUnqualifiedColumns(t *testing.T) {
+	col := func(parts ...string) ast.ExpressionAtom {
+		c := ast.ColumnNameExpressionAtom(parts)
+		return c
+	}
+
+	got := Dependencies(
+		[]string{"orders", "orders"},
+		[]ast.ExpressionAtom{col("id"), col("orders", "id")},
+	)
+
+	want := []string{"orders"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Dependencies() = %v, want %v", got, want)
+	}
+}
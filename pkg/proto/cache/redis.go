@@ -0,0 +1,137 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"time"
+)
+
+// RedisClient is the subset of a Redis client arana's cache adapter relies
+// on. Keeping it this small keeps the cache abstraction testable and the
+// driver swappable without this package depending on one directly. A
+// driver such as github.com/redis/go-redis/v9, whose methods return command
+// values rather than (value, error) pairs, is used through a thin wrapper
+// that implements these methods.
+type RedisClient interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value string, ttl time.Duration) error
+	Del(ctx context.Context, keys ...string) error
+	SMembers(ctx context.Context, key string) ([]string, error)
+	SAdd(ctx context.Context, key string, members ...string) error
+}
+
+// RedisStore is a Store backed by a shared Redis instance, suitable for a
+// multi-node arana deployment where the cache must be consistent across
+// proxy instances.
+type RedisStore struct {
+	client RedisClient
+	ttl    time.Duration
+
+	hits   uint64
+	misses uint64
+}
+
+// NewRedisStore wraps client as a Store, applying ttl to entries that don't
+// already carry one.
+func NewRedisStore(client RedisClient, ttl time.Duration) *RedisStore {
+	return &RedisStore{client: client, ttl: ttl}
+}
+
+func (s *RedisStore) Get(key Key) (*Entry, bool) {
+	ctx := context.Background()
+	raw, err := s.client.Get(ctx, string(key))
+	if err != nil || raw == "" {
+		atomic.AddUint64(&s.misses, 1)
+		return nil, false
+	}
+
+	var entry Entry
+	if err := json.Unmarshal([]byte(raw), &entry); err != nil {
+		atomic.AddUint64(&s.misses, 1)
+		return nil, false
+	}
+	if entry.expired(time.Now()) {
+		_ = s.client.Del(ctx, string(key))
+		atomic.AddUint64(&s.misses, 1)
+		return nil, false
+	}
+
+	atomic.AddUint64(&s.hits, 1)
+	return &entry, true
+}
+
+func (s *RedisStore) Set(entry *Entry) {
+	ctx := context.Background()
+
+	if entry.ExpiresAt.IsZero() && s.ttl > 0 {
+		entry.ExpiresAt = time.Now().Add(s.ttl)
+	}
+
+	// Redis treats a zero-or-negative ttl argument as "never expire", so an
+	// entry that already carries an ExpiresAt must translate that into the
+	// remaining duration rather than falling back to s.ttl, or a short-lived
+	// entry set after a long-lived one would leak forever.
+	ttl := s.ttl
+	if !entry.ExpiresAt.IsZero() {
+		if remaining := time.Until(entry.ExpiresAt); remaining > 0 {
+			ttl = remaining
+		} else {
+			ttl = time.Millisecond
+		}
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := s.client.Set(ctx, string(entry.Key), string(raw), ttl); err != nil {
+		return
+	}
+	for _, table := range entry.Dependencies {
+		_ = s.client.SAdd(ctx, tableIndexKey(table), string(entry.Key))
+	}
+}
+
+func (s *RedisStore) Evict(tables []string) {
+	ctx := context.Background()
+	for _, table := range tables {
+		keys, err := s.client.SMembers(ctx, tableIndexKey(table))
+		if err != nil || len(keys) == 0 {
+			continue
+		}
+		_ = s.client.Del(ctx, keys...)
+		_ = s.client.Del(ctx, tableIndexKey(table))
+	}
+}
+
+// Stats reports the hit and miss counters of this RedisStore instance.
+// Entries and SizeBytes are always zero: the footprint of the shared Redis
+// instance is not queried.
+func (s *RedisStore) Stats() Stats {
+	return Stats{
+		Hits:   atomic.LoadUint64(&s.hits),
+		Misses: atomic.LoadUint64(&s.misses),
+	}
+}
+
+func tableIndexKey(table string) string {
+	return "arana:cache:table:" + table
+}
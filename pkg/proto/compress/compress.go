@@ -0,0 +1,77 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package compress implements the compressed packet framing used by the
+// MySQL wire protocol, with a pluggable Codec so the negotiated algorithm
+// and its compression level can be configured per listener.
+package compress
+
+import (
+	"sync"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Codec compresses and decompresses the payload of a single MySQL
+// compressed packet.
+type Codec interface {
+	// Name is the wire identifier negotiated during the handshake, eg.
+	// "zlib" or "zstd".
+	Name() string
+	// Encode compresses src at the given level and returns the compressed
+	// bytes, appending to dst.
+	Encode(level int, dst, src []byte) ([]byte, error)
+	// Decode decompresses src and returns the original bytes, appending to
+	// dst.
+	Decode(dst, src []byte) ([]byte, error)
+	// DefaultLevel is used when a listener's config doesn't set one.
+	DefaultLevel() int
+}
+
+var (
+	_registryMu sync.RWMutex
+	_registry   = make(map[string]Codec)
+)
+
+// Register adds codec to the set of codecs that can be negotiated by name.
+// It panics on a duplicate name, mirroring other plugin registries in
+// arana (eg. pkg/advisor.Register).
+func Register(codec Codec) {
+	_registryMu.Lock()
+	defer _registryMu.Unlock()
+
+	name := codec.Name()
+	if _, ok := _registry[name]; ok {
+		panic(errors.Errorf("compress: codec %s already registered", name))
+	}
+	_registry[name] = codec
+}
+
+// Lookup returns the codec registered under name, if any.
+func Lookup(name string) (Codec, bool) {
+	_registryMu.RLock()
+	defer _registryMu.RUnlock()
+	codec, ok := _registry[name]
+	return codec, ok
+}
+
+func init() {
+	Register(&zlibCodec{})
+	Register(&zstdCodec{})
+}
@@ -0,0 +1,88 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// benchPayload builds a result-set-shaped payload: rows repeated, only
+// mildly varying rows, which is representative of what a SELECT response
+// looks like on the wire and is the traffic this codec exists to shrink
+// for WAN links.
+func benchPayload(rows int) []byte {
+	var sb strings.Builder
+	for i := 0; i < rows; i++ {
+		sb.WriteString("row-")
+		sb.WriteString(strconv.Itoa(i))
+		sb.WriteString("\tuser")
+		sb.WriteString(strconv.Itoa(i % 997))
+		sb.WriteString("\t2024-01-01 00:00:00\tactive\n")
+	}
+	return []byte(sb.String())
+}
+
+// BenchmarkCodecs reports, for each codec and level, the time to compress
+// and to decompress a result-set-shaped payload and, as the
+// "compressed-ratio" metric, the compressed size over the original size —
+// the CPU-vs-bytes-on-wire tradeoff that decides whether a given codec and
+// level are worth negotiating for a WAN link.
+func BenchmarkCodecs(b *testing.B) {
+	payload := benchPayload(2000)
+
+	for _, codecName := range []string{"zlib", "zstd"} {
+		codec, ok := Lookup(codecName)
+		if !ok {
+			b.Fatalf("codec %q is not registered", codecName)
+		}
+
+		for _, level := range []int{1, 3, 9} {
+			b.Run(fmt.Sprintf("%s/level=%d/encode", codecName, level), func(b *testing.B) {
+				b.SetBytes(int64(len(payload)))
+
+				var compressed []byte
+				for i := 0; i < b.N; i++ {
+					var err error
+					compressed, err = codec.Encode(level, nil, payload)
+					if err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(float64(len(compressed))/float64(len(payload)), "compressed-ratio")
+			})
+
+			b.Run(fmt.Sprintf("%s/level=%d/decode", codecName, level), func(b *testing.B) {
+				compressed, err := codec.Encode(level, nil, payload)
+				if err != nil {
+					b.Fatal(err)
+				}
+				b.SetBytes(int64(len(payload)))
+				b.ResetTimer()
+
+				for i := 0; i < b.N; i++ {
+					if _, err := codec.Decode(nil, compressed); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
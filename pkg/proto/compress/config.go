@@ -0,0 +1,53 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+// Config describes, through its yaml/json tags, a listener's `compression`
+// section in the bootstrap configuration, eg:
+//
+//	listeners:
+//	  - protocol_type: mysql
+//	    compression:
+//	      codec: zstd
+//	      level: 3
+//
+// This package does not read the bootstrap configuration itself; Frame and
+// Unframe take an already-decoded Config.
+type Config struct {
+	// Codec is the negotiated codec name, eg. "zlib" or "zstd". An empty
+	// value disables compression for the listener.
+	Codec string `yaml:"codec" json:"codec"`
+	// Level is passed to the codec's Encode. Nil means "use the codec's own
+	// default" — a pointer is used because 0 is itself a valid level for
+	// some codecs (eg. zlib.NoCompression).
+	Level *int `yaml:"level" json:"level"`
+}
+
+// Enabled reports whether the listener negotiates compression at all.
+func (c Config) Enabled() bool {
+	return c.Codec != ""
+}
+
+// ResolveLevel returns the level to pass to the codec's Encode, falling
+// back to the codec's own default when the config doesn't set one.
+func (c Config) ResolveLevel(codec Codec) int {
+	if c.Level != nil {
+		return *c.Level
+	}
+	return codec.DefaultLevel()
+}
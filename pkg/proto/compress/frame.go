@@ -0,0 +1,107 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"github.com/pkg/errors"
+)
+
+// HeaderSize is the length, in bytes, of a MySQL compressed-packet header:
+// a 3-byte compressed payload length, a 1-byte compressed sequence id, and
+// a 3-byte uncompressed payload length (zero when the payload wasn't worth
+// compressing and was sent as-is).
+//
+// Nothing in this package advertises CLIENT_COMPRESS (zlib) or
+// CLIENT_ZSTD_COMPRESSION_ALGORITHM (zstd) in the handshake or negotiates a
+// codec with the client; Frame and Unframe use whatever Config the caller
+// passes in.
+const HeaderSize = 7
+
+// Frame wraps payload — one or more ordinary MySQL packets, concatenated —
+// in a compressed-packet header and returns the result appended to dst. seq
+// is the compressed packet's own sequence id, distinct from the sequence
+// ids of the ordinary packets it carries.
+//
+// When cfg disables compression, or compressing payload doesn't actually
+// shrink it, the header's uncompressed-length field is left at zero and
+// payload is carried verbatim, exactly as the protocol requires.
+func Frame(cfg Config, seq byte, dst, payload []byte) ([]byte, error) {
+	if cfg.Enabled() {
+		codec, ok := Lookup(cfg.Codec)
+		if !ok {
+			return nil, errors.Errorf("compress: unknown codec %q", cfg.Codec)
+		}
+
+		compressed, err := codec.Encode(cfg.ResolveLevel(codec), nil, payload)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if len(compressed) < len(payload) {
+			return appendFrame(dst, seq, compressed, len(payload)), nil
+		}
+	}
+
+	return appendFrame(dst, seq, payload, 0), nil
+}
+
+// Unframe parses a single compressed packet from the front of src —
+// header plus body, with no trailing bytes — and returns its sequence id
+// and decompressed payload.
+func Unframe(cfg Config, src []byte) (seq byte, payload []byte, err error) {
+	if len(src) < HeaderSize {
+		return 0, nil, errors.New("compress: truncated compressed packet header")
+	}
+
+	bodyLen := int(src[0]) | int(src[1])<<8 | int(src[2])<<16
+	seq = src[3]
+	uncompressedLen := int(src[4]) | int(src[5])<<8 | int(src[6])<<16
+
+	body := src[HeaderSize:]
+	if len(body) != bodyLen {
+		return 0, nil, errors.New("compress: compressed packet body length does not match its header")
+	}
+
+	if uncompressedLen == 0 {
+		return seq, body, nil
+	}
+
+	if !cfg.Enabled() {
+		return 0, nil, errors.New("compress: received a compressed payload but no codec is configured")
+	}
+	codec, ok := Lookup(cfg.Codec)
+	if !ok {
+		return 0, nil, errors.Errorf("compress: unknown codec %q", cfg.Codec)
+	}
+
+	out, err := codec.Decode(make([]byte, 0, uncompressedLen), body)
+	if err != nil {
+		return 0, nil, errors.WithStack(err)
+	}
+	return seq, out, nil
+}
+
+// appendFrame writes a compressed-packet header for a body of bodyLen
+// bytes, a sequence id of seq, and an original length of uncompressedLen
+// (0 meaning "body is carried uncompressed"), followed by body itself.
+func appendFrame(dst []byte, seq byte, body []byte, uncompressedLen int) []byte {
+	n := len(body)
+	dst = append(dst, byte(n), byte(n>>8), byte(n>>16))
+	dst = append(dst, seq)
+	dst = append(dst, byte(uncompressedLen), byte(uncompressedLen>>8), byte(uncompressedLen>>16))
+	return append(dst, body...)
+}
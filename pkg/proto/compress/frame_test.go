@@ -0,0 +1,159 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// compressiblePayload is large and repetitive enough that every registered
+// codec actually shrinks it, exercising Frame's compressed path.
+func compressiblePayload() []byte {
+	var sb strings.Builder
+	for i := 0; i < 500; i++ {
+		sb.WriteString("row-")
+		sb.WriteString(strconv.Itoa(i % 7))
+		sb.WriteString("\tuser\t2024-01-01 00:00:00\tactive\n")
+	}
+	return []byte(sb.String())
+}
+
+func TestFrameUnframeRoundTrip(t *testing.T) {
+	for _, codecName := range []string{"zlib", "zstd"} {
+		t.Run(codecName, func(t *testing.T) {
+			cfg := Config{Codec: codecName}
+			payload := compressiblePayload()
+
+			framed, err := Frame(cfg, 7, nil, payload)
+			if err != nil {
+				t.Fatalf("Frame: %v", err)
+			}
+
+			seq, got, err := Unframe(cfg, framed)
+			if err != nil {
+				t.Fatalf("Unframe: %v", err)
+			}
+			if seq != 7 {
+				t.Fatalf("expected seq 7, got %d", seq)
+			}
+			if !bytes.Equal(got, payload) {
+				t.Fatalf("round-tripped payload does not match original")
+			}
+		})
+	}
+}
+
+func TestFrameCarriesIncompressiblePayloadVerbatim(t *testing.T) {
+	cfg := Config{Codec: "zstd"}
+	// A single byte cannot shrink under any real codec: the compressed
+	// form always carries at least a header/trailer of its own.
+	payload := []byte{0x2a}
+
+	framed, err := Frame(cfg, 1, nil, payload)
+	if err != nil {
+		t.Fatalf("Frame: %v", err)
+	}
+
+	uncompressedLen := int(framed[4]) | int(framed[5])<<8 | int(framed[6])<<16
+	if uncompressedLen != 0 {
+		t.Fatalf("expected uncompressedLen 0 for a verbatim-carried payload, got %d", uncompressedLen)
+	}
+
+	seq, got, err := Unframe(cfg, framed)
+	if err != nil {
+		t.Fatalf("Unframe: %v", err)
+	}
+	if seq != 1 {
+		t.Fatalf("expected seq 1, got %d", seq)
+	}
+	if !bytes.Equal(got, payload) {
+		t.Fatalf("expected payload to round-trip verbatim, got %v", got)
+	}
+}
+
+func TestUnframeRejectsTruncatedHeader(t *testing.T) {
+	cfg := Config{Codec: "zlib"}
+	_, _, err := Unframe(cfg, make([]byte, HeaderSize-1))
+	if err == nil {
+		t.Fatal("expected an error for a header shorter than HeaderSize")
+	}
+}
+
+func TestUnframeRejectsBodyLengthMismatch(t *testing.T) {
+	cfg := Config{Codec: "zlib"}
+	payload := []byte("hello")
+
+	framed, err := Frame(cfg, 0, nil, payload)
+	if err != nil {
+		t.Fatalf("Frame: %v", err)
+	}
+
+	t.Run("trailing_bytes", func(t *testing.T) {
+		withTrailer := append(append([]byte{}, framed...), 0xff)
+		if _, _, err := Unframe(cfg, withTrailer); err == nil {
+			t.Fatal("expected an error for bytes trailing the declared body length")
+		}
+	})
+
+	t.Run("truncated_body", func(t *testing.T) {
+		truncated := framed[:len(framed)-1]
+		if _, _, err := Unframe(cfg, truncated); err == nil {
+			t.Fatal("expected an error for a body shorter than the declared length")
+		}
+	})
+}
+
+// fakeCodec is a minimal stand-in whose DefaultLevel is distinguishable
+// from any level a test might configure, so ResolveLevel's fallback can be
+// observed directly.
+type fakeCodec struct{}
+
+func (fakeCodec) Name() string                                      { return "fake" }
+func (fakeCodec) DefaultLevel() int                                 { return 5 }
+func (fakeCodec) Encode(level int, dst, src []byte) ([]byte, error) { return append(dst, src...), nil }
+func (fakeCodec) Decode(dst, src []byte) ([]byte, error)            { return append(dst, src...), nil }
+
+func TestConfigResolveLevel(t *testing.T) {
+	codec := fakeCodec{}
+
+	t.Run("nil_level_falls_back_to_codec_default", func(t *testing.T) {
+		cfg := Config{Codec: "fake"}
+		if got := cfg.ResolveLevel(codec); got != codec.DefaultLevel() {
+			t.Fatalf("expected default level %d, got %d", codec.DefaultLevel(), got)
+		}
+	})
+
+	t.Run("explicit_level_is_honored", func(t *testing.T) {
+		level := 9
+		cfg := Config{Codec: "fake", Level: &level}
+		if got := cfg.ResolveLevel(codec); got != level {
+			t.Fatalf("expected explicit level %d, got %d", level, got)
+		}
+	})
+
+	t.Run("explicit_zero_level_is_honored", func(t *testing.T) {
+		level := 0
+		cfg := Config{Codec: "fake", Level: &level}
+		if got := cfg.ResolveLevel(codec); got != 0 {
+			t.Fatalf("expected explicit level 0, got %d", got)
+		}
+	})
+}
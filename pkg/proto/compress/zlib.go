@@ -0,0 +1,69 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+// zlibCodec is the classic `mysql_native_compress` codec negotiated by the
+// CLIENT_COMPRESS capability flag.
+type zlibCodec struct{}
+
+func (z *zlibCodec) Name() string {
+	return "zlib"
+}
+
+func (z *zlibCodec) DefaultLevel() int {
+	return zlib.DefaultCompression
+}
+
+func (z *zlibCodec) Encode(level int, dst, src []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := zlib.NewWriterLevel(&buf, level)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if _, err := w.Write(src); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return append(dst, buf.Bytes()...), nil
+}
+
+func (z *zlibCodec) Decode(dst, src []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer r.Close()
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return append(dst, out...), nil
+}
@@ -0,0 +1,125 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compress
+
+import (
+	"sync"
+)
+
+import (
+	"github.com/klauspost/compress/zstd"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+// zstdCodec is negotiated via the MySQL 8 CLIENT_ZSTD_COMPRESSION_ALGORITHM
+// capability. Unlike zlib, zstd exposes its level as an enum of presets
+// rather than an arbitrary integer, so levelToEncoderLevel maps the
+// configured int onto the nearest preset.
+//
+// zstd.Encoder and zstd.Decoder are documented as safe for concurrent use
+// and expensive to construct (each owns background goroutines), so the
+// single registered zstdCodec instance lazily builds one decoder and one
+// encoder per zstd preset (levels that map to the same preset share an
+// encoder) and reuses them for the life of the process instead of
+// allocating a fresh one per packet.
+type zstdCodec struct {
+	decoderOnce sync.Once
+	decoder     *zstd.Decoder
+	decoderErr  error
+
+	encoders sync.Map // zstd.EncoderLevel -> *zstd.Encoder
+}
+
+func (z *zstdCodec) Name() string {
+	return "zstd"
+}
+
+func (z *zstdCodec) DefaultLevel() int {
+	return 3
+}
+
+func (z *zstdCodec) Encode(level int, dst, src []byte) ([]byte, error) {
+	enc, err := z.encoderFor(levelToEncoderLevel(level))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return enc.EncodeAll(src, dst), nil
+}
+
+func (z *zstdCodec) Decode(dst, src []byte) ([]byte, error) {
+	dec, err := z.sharedDecoder()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	out, err := dec.DecodeAll(src, dst)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return out, nil
+}
+
+// sharedDecoder lazily builds the single zstd.Decoder shared across every
+// Decode call, since a Decoder takes no level and is safe for concurrent
+// DecodeAll use.
+func (z *zstdCodec) sharedDecoder() (*zstd.Decoder, error) {
+	z.decoderOnce.Do(func() {
+		z.decoder, z.decoderErr = zstd.NewReader(nil)
+	})
+	return z.decoder, z.decoderErr
+}
+
+// encoderFor lazily builds and caches the zstd.Encoder for level, so that
+// repeated Encode calls at the same level reuse one encoder instead of
+// paying its setup cost on every packet.
+func (z *zstdCodec) encoderFor(level zstd.EncoderLevel) (*zstd.Encoder, error) {
+	if cached, ok := z.encoders.Load(level); ok {
+		return cached.(*zstd.Encoder), nil
+	}
+
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(level))
+	if err != nil {
+		return nil, err
+	}
+
+	if actual, loaded := z.encoders.LoadOrStore(level, enc); loaded {
+		enc.Close()
+		return actual.(*zstd.Encoder), nil
+	}
+	return enc, nil
+}
+
+// levelToEncoderLevel maps a MySQL-style 1..22 compression level onto one
+// of zstd's four speed/ratio presets.
+func levelToEncoderLevel(level int) zstd.EncoderLevel {
+	switch {
+	case level <= 0:
+		return zstd.SpeedDefault
+	case level <= 1:
+		return zstd.SpeedFastest
+	case level <= 3:
+		return zstd.SpeedDefault
+	case level <= 9:
+		return zstd.SpeedBetterCompression
+	default:
+		return zstd.SpeedBestCompression
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"strings"
+	"unicode"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+// explainAdvisorPrefix is the keyword sequence that introduces an advisor
+// explain. It is matched ahead of the upstream grammar because
+// `EXPLAIN ADVISOR <stmt>` is an arana-only extension the vendored MySQL
+// parser has no production for.
+const explainAdvisorPrefix = "EXPLAIN ADVISOR"
+
+// ParseExplainAdvisor recognizes the `EXPLAIN ADVISOR <stmt>` extension at
+// the front of sql. On a match, it strips the prefix and hands the
+// remainder to parseInner (the statement dispatcher's regular entry point)
+// to parse as an ordinary statement, wrapping the result. It returns
+// ok=false, leaving sql untouched, for every statement that isn't this
+// extension — including one where "ADVISOR" is merely the start of a
+// longer identifier, eg. `EXPLAIN ADVISORY_LOCK_TABLE` — so callers fall
+// through to normal parsing.
+func ParseExplainAdvisor(sql string, parseInner func(string) (Statement, error)) (stmt *ExplainAdvisorStatement, ok bool, err error) {
+	trimmed := strings.TrimSpace(sql)
+	if len(trimmed) < len(explainAdvisorPrefix) || !strings.EqualFold(trimmed[:len(explainAdvisorPrefix)], explainAdvisorPrefix) {
+		return nil, false, nil
+	}
+	if len(trimmed) > len(explainAdvisorPrefix) && !unicode.IsSpace(rune(trimmed[len(explainAdvisorPrefix)])) {
+		return nil, false, nil
+	}
+
+	rest := strings.TrimSpace(trimmed[len(explainAdvisorPrefix):])
+	if rest == "" {
+		return nil, true, errors.New("EXPLAIN ADVISOR requires a statement to analyze")
+	}
+
+	inner, err := parseInner(rest)
+	if err != nil {
+		return nil, true, errors.WithStack(err)
+	}
+
+	return &ExplainAdvisorStatement{Stmt: inner}, true, nil
+}
@@ -0,0 +1,87 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"testing"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+func parseInnerStub(sql string) (Statement, error) {
+	if sql == "BOOM" {
+		return nil, errors.New("boom")
+	}
+	return nil, nil
+}
+
+func TestParseExplainAdvisor_NotMatched(t *testing.T) {
+	cases := []string{
+		"SELECT 1",
+		"explain select 1",
+		"EXPLAIN ADVISOR",
+		"  ",
+		"EXPLAIN ADVISORY_LOCK_TABLE",
+	}
+	for _, sql := range cases {
+		if sql == "EXPLAIN ADVISOR" {
+			continue // exercised separately below: matches but errors
+		}
+		_, ok, err := ParseExplainAdvisor(sql, parseInnerStub)
+		if ok {
+			t.Errorf("ParseExplainAdvisor(%q) matched unexpectedly", sql)
+		}
+		if err != nil {
+			t.Errorf("ParseExplainAdvisor(%q) returned unexpected error: %v", sql, err)
+		}
+	}
+}
+
+func TestParseExplainAdvisor_MissingInnerStatement(t *testing.T) {
+	_, ok, err := ParseExplainAdvisor("EXPLAIN ADVISOR", parseInnerStub)
+	if !ok {
+		t.Fatal("expected the prefix to match")
+	}
+	if err == nil {
+		t.Fatal("expected an error for a missing inner statement")
+	}
+}
+
+func TestParseExplainAdvisor_PropagatesInnerParseError(t *testing.T) {
+	_, ok, err := ParseExplainAdvisor("EXPLAIN ADVISOR BOOM", parseInnerStub)
+	if !ok {
+		t.Fatal("expected the prefix to match")
+	}
+	if err == nil {
+		t.Fatal("expected the inner parse error to propagate")
+	}
+}
+
+func TestParseExplainAdvisor_CaseInsensitivePrefix(t *testing.T) {
+	_, ok, err := ParseExplainAdvisor("explain advisor select 1", func(sql string) (Statement, error) {
+		if sql != "select 1" {
+			t.Fatalf("expected inner sql %q, got %q", "select 1", sql)
+		}
+		return nil, nil
+	})
+	if !ok || err != nil {
+		t.Fatalf("expected a case-insensitive match, got ok=%v err=%v", ok, err)
+	}
+}
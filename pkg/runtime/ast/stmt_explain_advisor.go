@@ -0,0 +1,60 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+import (
+	"strings"
+)
+
+import (
+	"github.com/pkg/errors"
+)
+
+var _ Statement = (*ExplainAdvisorStatement)(nil)
+
+// ExplainAdvisorStatement represents `EXPLAIN ADVISOR <stmt>`: rather than
+// explaining the physical plan, the executor runs pkg/advisor's heuristic
+// rules over Stmt and returns their findings as thead.Advisor rows. The
+// advisor package depends on this one (not the other way around), so that
+// wiring happens at the executor, not here. ParseExplainAdvisor recognizes
+// this statement's surface syntax ahead of the regular grammar.
+//
+// Neither the statement dispatcher that would call ParseExplainAdvisor nor
+// any concrete Visitor implementation (the optimizer/executor) exists in
+// this package set, so Accept's dispatch to VisitStatementExplainAdvisor,
+// while real against the Visitor interface below, has no live caller yet —
+// that lands with whichever change introduces those packages.
+type ExplainAdvisorStatement struct {
+	Stmt Statement
+}
+
+func (e *ExplainAdvisorStatement) Accept(visitor Visitor) (interface{}, error) {
+	return visitor.VisitStatementExplainAdvisor(e)
+}
+
+func (e *ExplainAdvisorStatement) Restore(flag RestoreFlag, sb *strings.Builder, args *[]int) error {
+	sb.WriteString("EXPLAIN ADVISOR ")
+	if err := e.Stmt.Restore(flag, sb, args); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (e *ExplainAdvisorStatement) CntParams() int {
+	return e.Stmt.CntParams()
+}
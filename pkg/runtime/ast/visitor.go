@@ -0,0 +1,47 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+// Statement is implemented by every top-level SQL statement arana can
+// execute: it can be restored back to SQL text and knows how many bound
+// parameters it expects.
+type Statement interface {
+	Node
+	Restorer
+	// CntParams returns the number of `?` placeholders in the statement.
+	CntParams() int
+}
+
+// Visitor is implemented by consumers that need to traverse the AST, eg.
+// the optimizer building a physical plan. Every Node's Accept dispatches to
+// exactly one of these methods.
+type Visitor interface {
+	VisitAtomInterval(*IntervalExpressionAtom) (interface{}, error)
+	VisitAtomSystemVariable(*SystemVariableExpressionAtom) (interface{}, error)
+	VisitAtomUnary(*UnaryExpressionAtom) (interface{}, error)
+	VisitAtomConstant(*ConstantExpressionAtom) (interface{}, error)
+	VisitAtomColumn(ColumnNameExpressionAtom) (interface{}, error)
+	VisitAtomVariable(VariableExpressionAtom) (interface{}, error)
+	VisitAtomMath(*MathExpressionAtom) (interface{}, error)
+	VisitAtomNested(*NestedExpressionAtom) (interface{}, error)
+	VisitAtomFunction(*FunctionCallExpressionAtom) (interface{}, error)
+
+	// VisitStatementExplainAdvisor visits an `EXPLAIN ADVISOR <stmt>`
+	// statement.
+	VisitStatementExplainAdvisor(*ExplainAdvisorStatement) (interface{}, error)
+}
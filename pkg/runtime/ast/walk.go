@@ -0,0 +1,67 @@
+/*
+ * Licensed to the Apache Software Foundation (ASF) under one or more
+ * contributor license agreements.  See the NOTICE file distributed with
+ * this work for additional information regarding copyright ownership.
+ * The ASF licenses this file to You under the Apache License, Version 2.0
+ * (the "License"); you may not use this file except in compliance with
+ * the License.  You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ast
+
+// ArgumentedFunc is satisfied by every concrete function-call node
+// (*Function, *AggrFunction, *CaseWhenElseFunction, *CastFunction) so
+// WalkAtom can descend into their arguments without knowing which one it's
+// holding.
+type ArgumentedFunc interface {
+	Args() []ExpressionAtom
+}
+
+// WalkAtom recurses into atom and every ExpressionAtom reachable from it,
+// invoking fn for each one. Walking stops early for a subtree as soon as fn
+// returns false.
+//
+// This lives here rather than in pkg/advisor or pkg/proto/cache because
+// both of those packages need to walk the exact same ExpressionAtom shapes
+// and had drifted into byte-for-byte duplicate copies of this function.
+func WalkAtom(atom ExpressionAtom, fn func(ExpressionAtom) bool) {
+	if atom == nil || !fn(atom) {
+		return
+	}
+
+	switch v := atom.(type) {
+	case *MathExpressionAtom:
+		WalkAtom(v.Left, fn)
+		WalkAtom(v.Right, fn)
+	case *UnaryExpressionAtom:
+		if inner, ok := v.Inner.(ExpressionAtom); ok {
+			WalkAtom(inner, fn)
+		}
+	case *FunctionCallExpressionAtom:
+		// F is one of *Function/*AggrFunction/*CaseWhenElseFunction/
+		// *CastFunction; rather than type-switching over all four, we only
+		// require that it exposes its arguments, which every one of them
+		// does.
+		if withArgs, ok := v.F.(ArgumentedFunc); ok {
+			for _, arg := range withArgs.Args() {
+				WalkAtom(arg, fn)
+			}
+		}
+	case *NestedExpressionAtom:
+		// First is an ExpressionNode, not an ExpressionAtom; nothing further
+		// to recurse into without re-implementing statement-level walking.
+	default:
+		// ColumnNameExpressionAtom, ConstantExpressionAtom,
+		// VariableExpressionAtom, SystemVariableExpressionAtom and
+		// IntervalExpressionAtom are leaves as far as ExpressionAtom
+		// recursion is concerned.
+	}
+}